@@ -0,0 +1,150 @@
+package gotwilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// UsagePager walks the pages of a Twilio Usage Records listing, handling
+// NextPageUri cleanup and fetch/parse plumbing so callers don't have to
+// re-implement the paging loop themselves. It's written specifically for
+// UsageResponse today; once this package takes on more paged resources
+// (Messages, Calls, Recordings), it's the natural seam to generalize into a
+// Pager[T] (this codebase doesn't use generics yet).
+type UsagePager struct {
+	twilio   *Twilio
+	url      string
+	pageSize int
+
+	started  bool
+	nextPage string
+}
+
+// NewUsagePager returns a UsagePager that will fetch pages starting at url.
+func NewUsagePager(twilio *Twilio, url string) *UsagePager {
+	return &UsagePager{twilio: twilio, url: url}
+}
+
+// PageSize sets the page size Twilio should return per request. A value of
+// 0 leaves Twilio's default page size in effect.
+func (p *UsagePager) PageSize(size int) {
+	p.pageSize = size
+}
+
+// PageToken returns the NextPageUri of the most recently fetched page, or ""
+// before the first call to Next or after the last page has been fetched.
+func (p *UsagePager) PageToken() string {
+	return p.nextPage
+}
+
+// HasMore reports whether a subsequent call to Next will fetch another
+// page. It's always true before the first call to Next.
+func (p *UsagePager) HasMore() bool {
+	return !p.started || p.nextPage != ""
+}
+
+// Next fetches and returns the next page of usage records. Callers should
+// check HasMore before calling Next in a loop; calling Next once the
+// listing is exhausted returns an empty, nil page.
+func (p *UsagePager) Next(ctx context.Context) ([]UsageRecord, *Exception, error) {
+	if p.started && p.nextPage == "" {
+		return nil, nil, nil
+	}
+
+	twilioUrl := p.url
+	if p.started {
+		twilioUrl = p.twilio.BaseUrl + cleanNextPageUri(p.twilio.BaseUrl, p.nextPage)
+	} else if p.pageSize > 0 {
+		twilioUrl += pageSizeQueryArg(twilioUrl, p.pageSize)
+	}
+
+	ctx, cancel := p.twilio.withRequestTimeout(ctx)
+	defer cancel()
+
+	p.twilio.debugLogRequest(http.MethodGet, twilioUrl, nil)
+	res, err := p.twilio.get(ctx, twilioUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+	p.twilio.debugLogResponse(res)
+
+	usageResponse, exception, err := parseResponse(res)
+	if exception != nil || err != nil {
+		return nil, exception, err
+	}
+
+	p.started = true
+	p.nextPage = usageResponse.NextPageUri
+	return usageResponse.UsageRecords, nil, nil
+}
+
+// pageSizeQueryArg returns a "?PageSize=" or "&PageSize=" query fragment,
+// choosing the separator based on whether twilioUrl already has a query
+// string.
+func pageSizeQueryArg(twilioUrl string, pageSize int) string {
+	sep := "?"
+	if strings.Contains(twilioUrl, "?") {
+		sep = "&"
+	}
+	return sep + "PageSize=" + strconv.Itoa(pageSize)
+}
+
+// cleanNextPageUri strips the "/2010-04-01" API-version segment that Twilio
+// duplicates between baseUrl and every NextPageUri, so the two can be
+// concatenated directly.
+func cleanNextPageUri(baseUrl, nextPageUri string) string {
+	uri := strings.Replace(nextPageUri, path.Base(baseUrl), "", 1)
+	return path.Clean(uri)
+}
+
+// GetUsagePages walks every page of usage records matching params, invoking
+// fn once per page. Paging stops as soon as fn returns a non-nil error,
+// which GetUsagePages then returns to the caller as its own error; this lets
+// callers stop early or apply backpressure without accumulating the whole
+// result set in memory the way GetUsageWithContext does. A Twilio-side
+// exception is also surfaced through this single error return (rather than
+// the *Exception, error pair most of this package's methods use), to match
+// the fn signature callers write against.
+func (twilio *Twilio) GetUsagePages(ctx context.Context, params UsageParameters, fn func(page []UsageRecord) error) error {
+	pager := NewUsagePager(twilio, twilio.usageRecordsUrl(params))
+
+	for pager.HasMore() {
+		page, exception, err := pager.Next(ctx)
+		if exception != nil {
+			return fmt.Errorf("twilio usage request failed: %+v", exception)
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(page); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// usageRecordsUrl builds the Usage Records listing URL for the given
+// parameters.
+func (twilio *Twilio) usageRecordsUrl(params UsageParameters) string {
+	return twilio.usageRecordsSubresourceUrl("", params)
+}
+
+// usageRecordsSubresourceUrl builds the URL for a Usage Records subresource
+// listing (Daily, Monthly, Yearly, AllTime, Today, Yesterday, ThisMonth,
+// LastMonth, ...). An empty subresource builds the top-level Records.json
+// listing.
+// See https://www.twilio.com/docs/usage/api/usage-record#subresources for
+// the available subresources.
+func (twilio *Twilio) usageRecordsSubresourceUrl(subresource string, params UsageParameters) string {
+	formValues := usageFormValues(string(params.Category), params.StartDate.String(), params.EndDate.String(), params.IncludeSubaccounts)
+	resourcePath := "/Accounts/" + twilio.AccountSid + "/Usage/Records"
+	if subresource != "" {
+		resourcePath += "/" + subresource
+	}
+	return twilio.BaseUrl + resourcePath + ".json?" + formValues.Encode()
+}
@@ -0,0 +1,40 @@
+package gotwilio
+
+import "testing"
+
+func TestCleanNextPageUri(t *testing.T) {
+	tests := []struct {
+		name        string
+		baseUrl     string
+		nextPageUri string
+		want        string
+	}{
+		{
+			name:        "strips the duplicated API version segment",
+			baseUrl:     "https://api.twilio.com/2010-04-01",
+			nextPageUri: "/2010-04-01/Accounts/ACxxx/Usage/Records.json?Page=1&PageToken=abc",
+			want:        "/Accounts/ACxxx/Usage/Records.json?Page=1&PageToken=abc",
+		},
+		{
+			name:        "no duplicated segment to strip",
+			baseUrl:     "https://api.twilio.com/2010-04-01",
+			nextPageUri: "/Accounts/ACxxx/Usage/Records.json?Page=2",
+			want:        "/Accounts/ACxxx/Usage/Records.json?Page=2",
+		},
+		{
+			name:        "cleans a doubled slash left behind after stripping",
+			baseUrl:     "https://api.twilio.com/2010-04-01",
+			nextPageUri: "/2010-04-01//Accounts/ACxxx/Usage/Records.json",
+			want:        "/Accounts/ACxxx/Usage/Records.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cleanNextPageUri(tt.baseUrl, tt.nextPageUri)
+			if got != tt.want {
+				t.Errorf("cleanNextPageUri(%q, %q) = %q, want %q", tt.baseUrl, tt.nextPageUri, got, tt.want)
+			}
+		})
+	}
+}
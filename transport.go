@@ -0,0 +1,145 @@
+package gotwilio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// debugHTTPTrafficEnv is the environment variable that, when set to any
+// non-empty value, turns on request/response dumping for every Twilio API
+// call this process makes.
+const debugHTTPTrafficEnv = "DEBUG_HTTP_TRAFFIC"
+
+// redactedHeaders lists headers never written to a DEBUG_HTTP_TRAFFIC dump,
+// since they carry Twilio credentials or request-signing secrets.
+var redactedHeaders = map[string]bool{
+	"Authorization":       true,
+	"X-Twilio-Signature":  true,
+	"Proxy-Authorization": true,
+}
+
+func debugHTTPTrafficEnabled() bool {
+	return os.Getenv(debugHTTPTrafficEnv) != ""
+}
+
+// SetDebugWriter redirects this client's DEBUG_HTTP_TRAFFIC dumps to w
+// instead of the default, os.Stderr. It's a method on *Twilio rather than a
+// package-level setting so that multiple *Twilio clients in the same
+// process can each dump to their own writer without racing one another.
+func (twilio *Twilio) SetDebugWriter(w io.Writer) {
+	twilio.debugWriter = w
+}
+
+// debugDumpWriter returns where this client's DEBUG_HTTP_TRAFFIC dumps go.
+func (twilio *Twilio) debugDumpWriter() io.Writer {
+	if twilio.debugWriter != nil {
+		return twilio.debugWriter
+	}
+	return os.Stderr
+}
+
+// SetTransport overrides the http.RoundTripper twilio's HTTP client uses to
+// make requests, e.g. to install a custom proxy or TLS config.
+func (twilio *Twilio) SetTransport(rt http.RoundTripper) {
+	twilio.HTTPClient.Transport = rt
+}
+
+// WithHTTPClient replaces twilio's underlying *http.Client and returns
+// twilio so calls can be chained, e.g.
+// twilio := NewTwilio(sid, token).WithHTTPClient(myClient).
+func (twilio *Twilio) WithHTTPClient(client *http.Client) *Twilio {
+	twilio.HTTPClient = client
+	return twilio
+}
+
+// SetTimeout sets a wall-clock deadline enforced around every individual
+// HTTP request twilio makes, via context.WithTimeout, including each page
+// fetched by a paginated call such as GetUsageWithContext. This catches
+// requests that would otherwise hang indefinitely on a socket that never
+// produces a read timeout on its own, e.g. a server that accepts the
+// connection but never responds. It's applied by every request path that
+// calls twilio.withRequestTimeout: today that's UsagePager.Next and the
+// Verify POST helpers.
+func (twilio *Twilio) SetTimeout(d time.Duration) {
+	twilio.timeout = d
+}
+
+// withRequestTimeout derives a context bounded by twilio's SetTimeout
+// duration, if any was set, for a single outgoing request. Callers must
+// invoke the returned cancel func once the request completes.
+func (twilio *Twilio) withRequestTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if twilio.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, twilio.timeout)
+}
+
+// debugLogRequest writes method, url, and the outgoing form body (if any)
+// to twilio's debug writer when DEBUG_HTTP_TRAFFIC is set. form is nil for
+// requests with no body, e.g. the GET requests UsagePager.Next makes; the
+// Verify POST helpers pass their form values so a developer debugging a
+// failed request can see exactly what was sent (which to/channel/code,
+// etc). It's called directly by the request call sites rather than through
+// the http.RoundTripper, so DEBUG_HTTP_TRAFFIC dumps traffic on a plain
+// NewTwilio client without requiring SetTransport or WithHTTPClient first.
+func (twilio *Twilio) debugLogRequest(method, reqUrl string, form url.Values) {
+	if !debugHTTPTrafficEnabled() {
+		return
+	}
+	fmt.Fprintf(twilio.debugDumpWriter(), "--- request: %s %s ---\n%s", method, reqUrl, dumpValues(form, nil))
+}
+
+// debugLogResponse writes res's status, headers (redacting auth headers),
+// and body to twilio's debug writer when DEBUG_HTTP_TRAFFIC is set. It
+// drains and restores res.Body, so the real response parsing downstream is
+// unaffected.
+func (twilio *Twilio) debugLogResponse(res *http.Response) {
+	if !debugHTTPTrafficEnabled() {
+		return
+	}
+	body := drainAndRestore(&res.Body)
+	fmt.Fprintf(twilio.debugDumpWriter(), "--- response: %s ---\n%s%s\n", res.Status, dumpHeaders(res.Header), body)
+}
+
+// drainAndRestore reads *body fully, replacing it with a fresh reader over
+// the same bytes so the real request/response processing downstream is
+// unaffected, and returns what was read.
+func drainAndRestore(body *io.ReadCloser) []byte {
+	if *body == nil {
+		return nil
+	}
+	data, _ := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(data))
+	return data
+}
+
+// dumpHeaders renders h as "Name: value" lines, redacting any header in
+// redactedHeaders.
+func dumpHeaders(h http.Header) string {
+	return dumpValues(h, redactedHeaders)
+}
+
+// dumpValues renders values (an http.Header or url.Values; both are
+// map[string][]string under the hood) as "Name: value" lines, redacting
+// any name present in redact.
+func dumpValues(values map[string][]string, redact map[string]bool) string {
+	var buf bytes.Buffer
+	for name, vals := range values {
+		if redact[name] {
+			fmt.Fprintf(&buf, "%s: REDACTED\n", name)
+			continue
+		}
+		for _, value := range vals {
+			fmt.Fprintf(&buf, "%s: %s\n", name, value)
+		}
+	}
+	return buf.String()
+}
@@ -6,19 +6,17 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"path"
 	"strconv"
-	"strings"
 )
 
 // These are the parameters to use when you are requesting account usage.
 // See https://www.twilio.com/docs/usage/api/usage-record#read-multiple-usagerecord-resources
 // for more info.
 type UsageParameters struct {
-	Category           string // Optional
-	StartDate          string // Optional, in YYYY-MM-DD or as offset
-	EndDate            string // Optional, in YYYY-MM-DD or as offset
-	IncludeSubaccounts bool   // Optional
+	Category           UsageCategory // Optional
+	StartDate          UsageDate     // Optional
+	EndDate            UsageDate     // Optional
+	IncludeSubaccounts bool          // Optional
 }
 
 // UsageRecord specifies the usage for a particular usage category.
@@ -53,6 +51,26 @@ func (twilio *Twilio) GetUsage(category, startDate, endDate string, includeSubac
 }
 
 func (twilio *Twilio) GetUsageWithContext(ctx context.Context, category, startDate, endDate string, includeSubaccounts bool) ([]UsageRecord, *Exception, error) {
+	formValues := usageFormValues(category, startDate, endDate, includeSubaccounts)
+	twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Usage/Records.json?" + formValues.Encode()
+
+	pager := NewUsagePager(twilio, twilioUrl)
+	var usageRecords []UsageRecord
+	for pager.HasMore() {
+		page, exception, err := pager.Next(ctx)
+		if exception != nil || err != nil {
+			return nil, exception, err
+		}
+		usageRecords = append(usageRecords, page...)
+	}
+
+	return usageRecords, nil, nil
+}
+
+// usageFormValues builds the form values shared by every Usage Records
+// request: GetUsageWithContext (and through it UsageClient), and, via
+// UsageParameters, GetUsagePages and the GetUsageRecords family.
+func usageFormValues(category, startDate, endDate string, includeSubaccounts bool) url.Values {
 	formValues := url.Values{}
 	if category != "" {
 		formValues.Set("Category", category)
@@ -64,37 +82,7 @@ func (twilio *Twilio) GetUsageWithContext(ctx context.Context, category, startDa
 		formValues.Set("EndDate", endDate)
 	}
 	formValues.Set("IncludeSubaccounts", strconv.FormatBool(includeSubaccounts))
-
-	var usageResponse *UsageResponse
-	var exception *Exception
-	var usageRecords []UsageRecord
-
-	for {
-		if usageResponse != nil && usageResponse.NextPageUri == "" {
-			break
-		}
-
-		twilioUrl := twilio.BaseUrl + "/Accounts/" + twilio.AccountSid + "/Usage/Records.json?" + formValues.Encode()
-		if usageResponse != nil && usageResponse.NextPageUri != "" {
-			// clean up "/2010-04-01" that appears at the end of twilio.BaseUrl and beginning of each NextPageUri
-			uri := strings.Replace(usageResponse.NextPageUri, path.Base(twilio.BaseUrl), "", 1)
-			twilioUrl = twilio.BaseUrl + path.Clean(uri)
-		}
-
-		res, err := twilio.get(ctx, twilioUrl)
-		if err != nil {
-			return nil, nil, err
-		}
-		defer res.Body.Close()
-
-		usageResponse, exception, err = parseResponse(res)
-		if exception != nil || err != nil {
-			return nil, exception, err
-		}
-		usageRecords = append(usageRecords, usageResponse.UsageRecords...)
-	}
-
-	return usageRecords, nil, nil
+	return formValues
 }
 
 func parseResponse(res *http.Response) (*UsageResponse, *Exception, error) {
@@ -0,0 +1,124 @@
+package gotwilio
+
+import "time"
+
+// UsageCategory identifies a Twilio usage category, narrowing the Category
+// field of a usage records request to one of Twilio's documented values
+// instead of an arbitrary string.
+// See https://www.twilio.com/docs/usage/api/usage-record#usage-categories
+// for the authoritative, evolving list.
+type UsageCategory string
+
+// Usage categories. This list covers the commonly used categories; Twilio
+// occasionally adds new ones, which can still be passed as UsageCategory("...")
+// until a constant is added here.
+const (
+	UsageCategoryA2PRegistrationFees                          UsageCategory = "a2p-registration-fees"
+	UsageCategoryAgentConference                              UsageCategory = "agent-conference"
+	UsageCategoryAnswerMachineDetection                       UsageCategory = "answering-machine-detection"
+	UsageCategoryAuthyAuthentications                         UsageCategory = "authy-authentications"
+	UsageCategoryAuthyCallsOutbound                           UsageCategory = "authy-calls-outbound"
+	UsageCategoryAuthyMonthlyFees                             UsageCategory = "authy-monthly-fees"
+	UsageCategoryAuthyPhoneIntelligence                       UsageCategory = "authy-phone-intelligence"
+	UsageCategoryAuthySMSOutbound                             UsageCategory = "authy-sms-outbound"
+	UsageCategoryCalls                                        UsageCategory = "calls"
+	UsageCategoryCallsClient                                  UsageCategory = "calls-client"
+	UsageCategoryCallsGlobalConference                        UsageCategory = "calls-globalconference"
+	UsageCategoryCallsInbound                                 UsageCategory = "calls-inbound"
+	UsageCategoryCallsInboundLocal                            UsageCategory = "calls-inbound-local"
+	UsageCategoryCallsInboundMobile                           UsageCategory = "calls-inbound-mobile"
+	UsageCategoryCallsInboundTollfree                         UsageCategory = "calls-inbound-tollfree"
+	UsageCategoryCallsOutbound                                UsageCategory = "calls-outbound"
+	UsageCategoryCallsOutboundLocal                           UsageCategory = "calls-outbound-local"
+	UsageCategoryCallsOutboundMobile                          UsageCategory = "calls-outbound-mobile"
+	UsageCategoryCallsOutboundTollfree                        UsageCategory = "calls-outbound-tollfree"
+	UsageCategoryCallsRecordings                              UsageCategory = "calls-recordings"
+	UsageCategoryCallsSip                                     UsageCategory = "calls-sip"
+	UsageCategoryCallsSipInbound                              UsageCategory = "calls-sip-inbound"
+	UsageCategoryCallsSipOutbound                             UsageCategory = "calls-sip-outbound"
+	UsageCategoryCallsTransfer                                UsageCategory = "calls-transfer"
+	UsageCategoryCarrierLookups                               UsageCategory = "carrier-lookups"
+	UsageCategoryConversations                                UsageCategory = "conversations"
+	UsageCategoryConversationsAPI                             UsageCategory = "conversations-api"
+	UsageCategoryConversationsConversationFees                UsageCategory = "conversations-conversation-fees"
+	UsageCategoryConversationsSMS                             UsageCategory = "conversations-sms"
+	UsageCategoryFlexUsage                                    UsageCategory = "flex-usage"
+	UsageCategoryGroupRooms                                   UsageCategory = "group-rooms"
+	UsageCategoryLookups                                      UsageCategory = "lookups"
+	UsageCategoryMarketplace                                  UsageCategory = "marketplace"
+	UsageCategoryMediaStorage                                 UsageCategory = "media-storage"
+	UsageCategoryMMS                                          UsageCategory = "mms"
+	UsageCategoryMMSInbound                                   UsageCategory = "mms-inbound"
+	UsageCategoryMMSInboundLongcode                           UsageCategory = "mms-inbound-longcode"
+	UsageCategoryMMSInboundShortcode                          UsageCategory = "mms-inbound-shortcode"
+	UsageCategoryMMSOutbound                                  UsageCategory = "mms-outbound"
+	UsageCategoryMMSOutboundLongcode                          UsageCategory = "mms-outbound-longcode"
+	UsageCategoryMMSOutboundShortcode                         UsageCategory = "mms-outbound-shortcode"
+	UsageCategoryNumberFormatLookups                          UsageCategory = "number-format-lookups"
+	UsageCategoryPeerToPeerRooms                              UsageCategory = "peer-to-peer-rooms"
+	UsageCategoryPhoneNumbers                                 UsageCategory = "phonenumbers"
+	UsageCategoryPhoneNumbersLocal                            UsageCategory = "phonenumbers-local"
+	UsageCategoryPhoneNumbersMobile                           UsageCategory = "phonenumbers-mobile"
+	UsageCategoryPhoneNumbersTollfree                         UsageCategory = "phonenumbers-tollfree"
+	UsageCategoryProxy                                        UsageCategory = "proxy"
+	UsageCategoryRecordings                                   UsageCategory = "recordings"
+	UsageCategoryRecordingStorage                             UsageCategory = "recordingstorage"
+	UsageCategoryRoomsParticipantMinutes                      UsageCategory = "rooms-participant-minutes"
+	UsageCategorySMS                                          UsageCategory = "sms"
+	UsageCategorySMSInbound                                   UsageCategory = "sms-inbound"
+	UsageCategorySMSInboundLongcode                           UsageCategory = "sms-inbound-longcode"
+	UsageCategorySMSInboundShortcode                          UsageCategory = "sms-inbound-shortcode"
+	UsageCategorySMSOutbound                                  UsageCategory = "sms-outbound"
+	UsageCategorySMSOutboundLongcode                          UsageCategory = "sms-outbound-longcode"
+	UsageCategorySMSOutboundShortcode                         UsageCategory = "sms-outbound-shortcode"
+	UsageCategoryStudioEngagements                            UsageCategory = "studio-engagements"
+	UsageCategoryTaskrouterTasks                              UsageCategory = "taskrouter-tasks"
+	UsageCategoryTotalPrice                                   UsageCategory = "totalprice"
+	UsageCategoryTranscriptions                               UsageCategory = "transcriptions"
+	UsageCategoryTrunkingOrigination                          UsageCategory = "trunking-origination"
+	UsageCategoryTrunkingTermination                          UsageCategory = "trunking-termination"
+	UsageCategoryVerifyPush                                   UsageCategory = "verify-push"
+	UsageCategoryVerifySNA                                    UsageCategory = "verify-sna"
+	UsageCategoryVerifyTOTP                                   UsageCategory = "verify-totp"
+	UsageCategoryVerifyWhatsappConversationsBusinessInitiated UsageCategory = "verify-whatsapp-conversations-business-initiated"
+	UsageCategoryVoiceInsights                                UsageCategory = "voice-insights"
+	UsageCategoryWireless                                     UsageCategory = "wireless"
+	UsageCategoryWirelessOrders                               UsageCategory = "wireless-orders"
+	UsageCategoryWirelessUsage                                UsageCategory = "wireless-usage"
+)
+
+// UsageDate represents a date value for a usage records request: either a
+// concrete time.Time (formatted as YYYY-MM-DD when sent to Twilio) or one of
+// the relative offset strings Twilio also accepts, e.g. "-30" for 30 days
+// ago or "today"/"yesterday"/"thismonth"/"lastmonth". The zero value sends
+// no date parameter at all.
+// See https://www.twilio.com/docs/usage/api/usage-record#specifying-dates-and-times
+// for the formats Twilio understands.
+type UsageDate struct {
+	t      time.Time
+	offset string
+}
+
+// NewUsageDate returns a UsageDate for an exact calendar date.
+func NewUsageDate(t time.Time) UsageDate {
+	return UsageDate{t: t}
+}
+
+// NewUsageDateOffset returns a UsageDate from one of Twilio's relative
+// offset strings (e.g. "-30", "today", "lastmonth").
+func NewUsageDateOffset(offset string) UsageDate {
+	return UsageDate{offset: offset}
+}
+
+// IsZero reports whether d carries no date at all.
+func (d UsageDate) IsZero() bool {
+	return d.t.IsZero() && d.offset == ""
+}
+
+// String renders d the way Twilio expects it on the wire.
+func (d UsageDate) String() string {
+	if !d.t.IsZero() {
+		return d.t.Format(dateOnly)
+	}
+	return d.offset
+}
@@ -0,0 +1,237 @@
+package gotwilio
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// dateOnly is the layout Twilio expects (and returns) for usage record
+// start/end dates.
+const dateOnly = "2006-01-02"
+
+// dateTimeWithOffset is the layout Twilio uses for the "as_of" timestamp on
+// usage records, e.g. "2021-05-01T00:00:00+00:00".
+const dateTimeWithOffset = "2006-01-02T15:04:05-07:00"
+
+// TypedUsageRecord is a UsageRecord with its date and numeric fields parsed
+// into native Go types. The original string fields are kept so callers that
+// depended on UsageRecord's raw representation aren't broken.
+type TypedUsageRecord struct {
+	UsageRecord
+
+	StartDateTime time.Time
+	EndDateTime   time.Time
+	AsOfTime      time.Time
+	PriceFloat    float64
+	UsageFloat    float64
+}
+
+// newTypedUsageRecord parses the string fields of rec into their typed
+// equivalents. Parse errors are ignored field-by-field so a single
+// unexpected format from Twilio doesn't discard an otherwise usable record;
+// the zero value is left in place for whichever field failed to parse.
+func newTypedUsageRecord(rec UsageRecord) TypedUsageRecord {
+	typed := TypedUsageRecord{UsageRecord: rec}
+	if t, err := time.Parse(dateOnly, rec.StartDate); err == nil {
+		typed.StartDateTime = t
+	}
+	if t, err := time.Parse(dateOnly, rec.EndDate); err == nil {
+		typed.EndDateTime = t
+	}
+	if t, err := time.Parse(dateTimeWithOffset, rec.AsOf); err == nil {
+		typed.AsOfTime = t
+	}
+	if f, err := strconv.ParseFloat(rec.Price, 64); err == nil {
+		typed.PriceFloat = f
+	}
+	if f, err := strconv.ParseFloat(rec.Usage, 64); err == nil {
+		typed.UsageFloat = f
+	}
+	return typed
+}
+
+// UsageClient fetches usage records, optionally splitting a wide date range
+// into sub-ranges fetched concurrently. Twilio must be set (NewUsageClient
+// does so); Concurrency defaults to 1 (fully serial).
+type UsageClient struct {
+	Twilio *Twilio
+
+	// Concurrency bounds how many sub-range requests are in flight at once.
+	// Values <= 1 fetch sub-ranges serially.
+	Concurrency int
+}
+
+// NewUsageClient returns a UsageClient backed by twilio.
+func NewUsageClient(twilio *Twilio) *UsageClient {
+	return &UsageClient{Twilio: twilio}
+}
+
+// usageRange is one [start, end] (inclusive) slice of a larger date range,
+// fetched by a single worker.
+type usageRange struct {
+	start time.Time
+	end   time.Time
+}
+
+// splitRange divides [start, end] into at most n contiguous, non-overlapping
+// sub-ranges of roughly equal length. Each sub-range's end is one
+// nanosecond before the next sub-range's start, since GetUsageWithContext
+// treats StartDate/EndDate as an inclusive range: without that gap, the
+// boundary instant (and, once formatted to a day-granularity date, the
+// whole day it falls on) would be fetched by two workers and double-counted
+// by GetUsageInRange/IterUsage. Each sub-range spans a whole number of days
+// and every boundary falls on midnight, so no calendar day straddles two
+// sub-ranges. If n <= 1 or the range is shorter than n days, the whole range
+// is returned as a single sub-range.
+func splitRange(start, end time.Time, n int) []usageRange {
+	if n <= 1 || !end.After(start) {
+		return []usageRange{{start, end}}
+	}
+
+	total := end.Sub(start)
+	stepDays := int(total / time.Duration(n) / (24 * time.Hour))
+	if stepDays <= 0 {
+		return []usageRange{{start, end}}
+	}
+
+	ranges := make([]usageRange, 0, n)
+	cursor := start
+	for i := 0; i < n-1; i++ {
+		next := time.Date(cursor.Year(), cursor.Month(), cursor.Day()+stepDays, 0, 0, 0, 0, cursor.Location())
+		ranges = append(ranges, usageRange{cursor, next.Add(-time.Nanosecond)})
+		cursor = next
+	}
+	ranges = append(ranges, usageRange{cursor, end})
+	return ranges
+}
+
+// GetUsageInRange fetches every usage record for category between start and
+// end (inclusive), splitting the range across c.Concurrency workers. Results
+// arrive in whichever order each sub-range's worker happens to finish, not
+// the order sub-ranges were scheduled in, and not necessarily chronological
+// order.
+func (c *UsageClient) GetUsageInRange(ctx context.Context, category UsageCategory, start, end time.Time, includeSubaccounts bool) ([]TypedUsageRecord, error) {
+	records, errs := c.fetchRanges(ctx, category, start, end, includeSubaccounts)
+
+	var all []TypedUsageRecord
+	for records != nil || errs != nil {
+		select {
+		case batch, ok := <-records:
+			if !ok {
+				records = nil
+				continue
+			}
+			all = append(all, batch...)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			return nil, err
+		}
+	}
+	return all, nil
+}
+
+// IterUsage is like GetUsageInRange but streams records as they're fetched
+// instead of accumulating them all in memory. The record channel is closed
+// once every sub-range has been fetched or an error occurs; callers should
+// drain both channels.
+func (c *UsageClient) IterUsage(ctx context.Context, category UsageCategory, start, end time.Time, includeSubaccounts bool) (<-chan UsageRecord, <-chan error) {
+	out := make(chan UsageRecord)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(out)
+		defer close(errCh)
+
+		records, errs := c.fetchRanges(ctx, category, start, end, includeSubaccounts)
+
+		for records != nil || errs != nil {
+			select {
+			case batch, ok := <-records:
+				if !ok {
+					records = nil
+					continue
+				}
+				for _, rec := range batch {
+					select {
+					case out <- rec.UsageRecord:
+					case <-ctx.Done():
+						errCh <- ctx.Err()
+						return
+					}
+				}
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				errCh <- err
+				return
+			}
+		}
+	}()
+
+	return out, errCh
+}
+
+// fetchRanges splits [start, end] into c.concurrency() sub-ranges and fetches
+// each with its own worker goroutine, feeding results to the returned
+// records channel and any error to the returned errs channel. Both channels
+// are buffered to len(ranges) and closed once every worker has finished, so
+// a worker's send never blocks even if the caller stops draining one of the
+// two channels early (e.g. after the other channel delivers an error) —
+// without that buffer, the remaining workers would block forever trying to
+// send into an abandoned channel.
+func (c *UsageClient) fetchRanges(ctx context.Context, category UsageCategory, start, end time.Time, includeSubaccounts bool) (<-chan []TypedUsageRecord, <-chan error) {
+	ranges := splitRange(start, end, c.concurrency())
+	records := make(chan []TypedUsageRecord, len(ranges))
+	errs := make(chan error, len(ranges))
+
+	sem := make(chan struct{}, c.concurrency())
+	go func() {
+		var wg sync.WaitGroup
+		for _, r := range ranges {
+			r := r
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				recs, exception, err := c.Twilio.GetUsageWithContext(ctx, string(category), r.start.Format(dateOnly), r.end.Format(dateOnly), includeSubaccounts)
+				if exception != nil {
+					errs <- fmt.Errorf("twilio usage request failed: %+v", exception)
+					return
+				}
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				typed := make([]TypedUsageRecord, len(recs))
+				for i, rec := range recs {
+					typed[i] = newTypedUsageRecord(rec)
+				}
+				records <- typed
+			}()
+		}
+		wg.Wait()
+		close(records)
+		close(errs)
+	}()
+
+	return records, errs
+}
+
+// concurrency returns the effective worker count, defaulting to 1.
+func (c *UsageClient) concurrency() int {
+	if c.Concurrency <= 0 {
+		return 1
+	}
+	return c.Concurrency
+}
@@ -0,0 +1,78 @@
+package gotwilio
+
+import (
+	"testing"
+	"time"
+)
+
+// TestSplitRangeNoOverlap guards against the boundary-day double-count bug:
+// splitRange's sub-ranges must be half-open so the same calendar day is
+// never claimed by two adjacent sub-ranges (GetUsageWithContext treats
+// StartDate/EndDate as inclusive, so an overlapping boundary would be
+// fetched, and counted, twice by GetUsageInRange/IterUsage).
+func TestSplitRangeNoOverlap(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end time.Time
+		n          int
+		wantRanges int
+	}{
+		{
+			name:       "two-way split of a ten day range",
+			start:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:        time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+			n:          2,
+			wantRanges: 2,
+		},
+		{
+			name:       "three-way split of a nine day range",
+			start:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:        time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			n:          3,
+			wantRanges: 3,
+		},
+		{
+			name:       "concurrency of 1 stays a single range",
+			start:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:        time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+			n:          1,
+			wantRanges: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranges := splitRange(tt.start, tt.end, tt.n)
+			if len(ranges) != tt.wantRanges {
+				t.Fatalf("splitRange returned %d sub-ranges, want %d", len(ranges), tt.wantRanges)
+			}
+
+			claimedBy := make(map[string]int)
+			for i, r := range ranges {
+				for d := r.start; !d.After(r.end); d = d.AddDate(0, 0, 1) {
+					day := d.Format(dateOnly)
+					if owner, ok := claimedBy[day]; ok {
+						t.Fatalf("day %s claimed by both sub-range %d and sub-range %d: %v", day, owner, i, ranges)
+					}
+					claimedBy[day] = i
+				}
+			}
+
+			wantDays := int(tt.end.Sub(tt.start).Hours()/24) + 1
+			if len(claimedBy) != wantDays {
+				t.Fatalf("splitRange covered %d distinct days, want %d: %v", len(claimedBy), wantDays, ranges)
+			}
+		})
+	}
+}
+
+func TestSplitRangeEmptyRange(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ranges := splitRange(start, start, 4)
+	if len(ranges) != 1 {
+		t.Fatalf("splitRange(start, start, 4) = %v, want a single sub-range", ranges)
+	}
+	if !ranges[0].start.Equal(start) || !ranges[0].end.Equal(start) {
+		t.Fatalf("splitRange(start, start, 4) = %v, want the original [start, start]", ranges)
+	}
+}
@@ -0,0 +1,69 @@
+package gotwilio
+
+import "context"
+
+// GetUsageRecords fetches usage records matching params, consuming the
+// already-defined UsageParameters struct instead of positional arguments.
+// Unlike GetUsageWithContext, params.Category and the date fields are typed
+// (UsageCategory, UsageDate), giving callers compile-time checking.
+func (twilio *Twilio) GetUsageRecords(ctx context.Context, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	return twilio.getUsageRecordsSubresource(ctx, "", params)
+}
+
+// GetUsageRecordsDaily fetches usage records bucketed by day.
+func (twilio *Twilio) GetUsageRecordsDaily(ctx context.Context, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	return twilio.getUsageRecordsSubresource(ctx, "Daily", params)
+}
+
+// GetUsageRecordsMonthly fetches usage records bucketed by month.
+func (twilio *Twilio) GetUsageRecordsMonthly(ctx context.Context, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	return twilio.getUsageRecordsSubresource(ctx, "Monthly", params)
+}
+
+// GetUsageRecordsYearly fetches usage records bucketed by year.
+func (twilio *Twilio) GetUsageRecordsYearly(ctx context.Context, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	return twilio.getUsageRecordsSubresource(ctx, "Yearly", params)
+}
+
+// GetUsageRecordsAllTime fetches usage records covering the lifetime of the
+// account.
+func (twilio *Twilio) GetUsageRecordsAllTime(ctx context.Context, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	return twilio.getUsageRecordsSubresource(ctx, "AllTime", params)
+}
+
+// GetUsageRecordsToday fetches today's usage record.
+func (twilio *Twilio) GetUsageRecordsToday(ctx context.Context, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	return twilio.getUsageRecordsSubresource(ctx, "Today", params)
+}
+
+// GetUsageRecordsYesterday fetches yesterday's usage record.
+func (twilio *Twilio) GetUsageRecordsYesterday(ctx context.Context, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	return twilio.getUsageRecordsSubresource(ctx, "Yesterday", params)
+}
+
+// GetUsageRecordsThisMonth fetches the current month's usage record.
+func (twilio *Twilio) GetUsageRecordsThisMonth(ctx context.Context, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	return twilio.getUsageRecordsSubresource(ctx, "ThisMonth", params)
+}
+
+// GetUsageRecordsLastMonth fetches last month's usage record.
+func (twilio *Twilio) GetUsageRecordsLastMonth(ctx context.Context, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	return twilio.getUsageRecordsSubresource(ctx, "LastMonth", params)
+}
+
+// getUsageRecordsSubresource walks every page of the given Usage Records
+// subresource listing and returns the combined records.
+// See https://www.twilio.com/docs/usage/api/usage-record#subresources.
+func (twilio *Twilio) getUsageRecordsSubresource(ctx context.Context, subresource string, params UsageParameters) ([]UsageRecord, *Exception, error) {
+	pager := NewUsagePager(twilio, twilio.usageRecordsSubresourceUrl(subresource, params))
+
+	var usageRecords []UsageRecord
+	for pager.HasMore() {
+		page, exception, err := pager.Next(ctx)
+		if exception != nil || err != nil {
+			return nil, exception, err
+		}
+		usageRecords = append(usageRecords, page...)
+	}
+	return usageRecords, nil, nil
+}
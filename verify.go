@@ -0,0 +1,184 @@
+package gotwilio
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// VerificationResource represents a Twilio Verify verification.
+// See https://www.twilio.com/docs/verify/api/verification#verification-response-properties
+// for more info.
+type VerificationResource struct {
+	Sid         string `json:"sid"`
+	ServiceSid  string `json:"service_sid"`
+	AccountSid  string `json:"account_sid"`
+	To          string `json:"to"`
+	Channel     string `json:"channel"`
+	Status      string `json:"status"`
+	Valid       bool   `json:"valid"`
+	DateCreated string `json:"date_created"`
+	DateUpdated string `json:"date_updated"`
+	Lookup      struct {
+		CarrierInfo struct {
+			MobileCountryCode string `json:"mobile_country_code"`
+			MobileNetworkCode string `json:"mobile_network_code"`
+			Carrier           string `json:"carrier"`
+			Type              string `json:"type"`
+		} `json:"carrier"`
+	} `json:"lookup"`
+	Url string `json:"url"`
+}
+
+// VerificationCheckResource represents the result of checking a Twilio Verify
+// verification code.
+// See https://www.twilio.com/docs/verify/api/verification-check#check-a-verification-response
+// for more info.
+type VerificationCheckResource struct {
+	Sid         string `json:"sid"`
+	ServiceSid  string `json:"service_sid"`
+	AccountSid  string `json:"account_sid"`
+	To          string `json:"to"`
+	Channel     string `json:"channel"`
+	Status      string `json:"status"`
+	Valid       bool   `json:"valid"`
+	DateCreated string `json:"date_created"`
+	DateUpdated string `json:"date_updated"`
+}
+
+// VerificationServiceResource represents a Twilio Verify service, which acts
+// as a container for verifications of a particular kind (e.g. "My App Login").
+// See https://www.twilio.com/docs/verify/api/service#service-properties for
+// more info.
+type VerificationServiceResource struct {
+	Sid          string `json:"sid"`
+	AccountSid   string `json:"account_sid"`
+	FriendlyName string `json:"friendly_name"`
+	CodeLength   int    `json:"code_length"`
+	DateCreated  string `json:"date_created"`
+	DateUpdated  string `json:"date_updated"`
+	Url          string `json:"url"`
+}
+
+const verifyBaseUrl = "https://verify.twilio.com/v2"
+
+// CreateVerificationService creates a new Twilio Verify service, which is
+// required before verifications can be started against it.
+func (twilio *Twilio) CreateVerificationService(friendlyName string) (*VerificationServiceResource, *Exception, error) {
+	return twilio.CreateVerificationServiceWithContext(context.Background(), friendlyName)
+}
+
+// CreateVerificationServiceWithContext is like CreateVerificationService but
+// accepts a context that can be used to cancel the request or set a deadline.
+func (twilio *Twilio) CreateVerificationServiceWithContext(ctx context.Context, friendlyName string) (*VerificationServiceResource, *Exception, error) {
+	formValues := url.Values{}
+	formValues.Set("FriendlyName", friendlyName)
+
+	ctx, cancel := twilio.withRequestTimeout(ctx)
+	defer cancel()
+
+	requestUrl := verifyBaseUrl + "/Services"
+	twilio.debugLogRequest(http.MethodPost, requestUrl, formValues)
+	res, err := twilio.post(ctx, formValues, requestUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+	twilio.debugLogResponse(res)
+
+	service := new(VerificationServiceResource)
+	exception, err := parseVerifyResponse(res, service)
+	if exception != nil || err != nil {
+		return nil, exception, err
+	}
+	return service, nil, nil
+}
+
+// StartVerification begins a Twilio Verify verification for the given "to"
+// address (a phone number or email address, depending on channel) using the
+// verification service identified by serviceSid. Channel is one of "sms",
+// "call", "email", or "whatsapp".
+// See https://www.twilio.com/docs/verify/api/verification#start-new-verification
+// for more info.
+func (twilio *Twilio) StartVerification(serviceSid, to, channel string) (*VerificationResource, *Exception, error) {
+	return twilio.StartVerificationWithContext(context.Background(), serviceSid, to, channel)
+}
+
+// StartVerificationWithContext is like StartVerification but accepts a
+// context that can be used to cancel the request or set a deadline.
+func (twilio *Twilio) StartVerificationWithContext(ctx context.Context, serviceSid, to, channel string) (*VerificationResource, *Exception, error) {
+	formValues := url.Values{}
+	formValues.Set("To", to)
+	formValues.Set("Channel", channel)
+
+	ctx, cancel := twilio.withRequestTimeout(ctx)
+	defer cancel()
+
+	requestUrl := verifyBaseUrl + "/Services/" + serviceSid + "/Verifications"
+	twilio.debugLogRequest(http.MethodPost, requestUrl, formValues)
+	res, err := twilio.post(ctx, formValues, requestUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+	twilio.debugLogResponse(res)
+
+	verification := new(VerificationResource)
+	exception, err := parseVerifyResponse(res, verification)
+	if exception != nil || err != nil {
+		return nil, exception, err
+	}
+	return verification, nil, nil
+}
+
+// CheckVerification checks a code entered by a user against the verification
+// previously started for "to" on the given verification service.
+// See https://www.twilio.com/docs/verify/api/verification-check#check-a-verification
+// for more info.
+func (twilio *Twilio) CheckVerification(serviceSid, to, code string) (*VerificationCheckResource, *Exception, error) {
+	return twilio.CheckVerificationWithContext(context.Background(), serviceSid, to, code)
+}
+
+// CheckVerificationWithContext is like CheckVerification but accepts a
+// context that can be used to cancel the request or set a deadline.
+func (twilio *Twilio) CheckVerificationWithContext(ctx context.Context, serviceSid, to, code string) (*VerificationCheckResource, *Exception, error) {
+	formValues := url.Values{}
+	formValues.Set("To", to)
+	formValues.Set("Code", code)
+
+	ctx, cancel := twilio.withRequestTimeout(ctx)
+	defer cancel()
+
+	requestUrl := verifyBaseUrl + "/Services/" + serviceSid + "/VerificationCheck"
+	twilio.debugLogRequest(http.MethodPost, requestUrl, formValues)
+	res, err := twilio.post(ctx, formValues, requestUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+	twilio.debugLogResponse(res)
+
+	check := new(VerificationCheckResource)
+	exception, err := parseVerifyResponse(res, check)
+	if exception != nil || err != nil {
+		return nil, exception, err
+	}
+	return check, nil, nil
+}
+
+// parseVerifyResponse reads res.Body into v when the request succeeded, or
+// into an Exception when Twilio returned an error status.
+func parseVerifyResponse(res *http.Response, v interface{}) (*Exception, error) {
+	responseBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		exception := new(Exception)
+		err = json.Unmarshal(responseBody, exception)
+		return exception, err
+	}
+	return nil, json.Unmarshal(responseBody, v)
+}